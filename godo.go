@@ -0,0 +1,224 @@
+package godo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-querystring/query"
+)
+
+const (
+	libraryVersion = "1.0.0"
+	defaultBaseURL = "https://api.digitalocean.com/"
+	userAgent      = "godo/" + libraryVersion
+	mediaType      = "application/json"
+)
+
+// Client manages communication with the DigitalOcean V2 API.
+type Client struct {
+	client *http.Client
+
+	BaseURL   *url.URL
+	UserAgent string
+
+	Registry RegistryService
+}
+
+// ListOptions specifies the optional parameters to various List methods that
+// support pagination.
+type ListOptions struct {
+	Page    int `url:"page,omitempty"`
+	PerPage int `url:"per_page,omitempty"`
+}
+
+// TokenListOptions specifies the optional parameters to List methods that
+// support cursor-based pagination via an opaque page token rather than a
+// page number.
+type TokenListOptions struct {
+	Page    string `url:"page_token,omitempty"`
+	PerPage int    `url:"per_page,omitempty"`
+}
+
+// Response is a DigitalOcean response that wraps http.Response.
+type Response struct {
+	*http.Response
+
+	Links *Links
+	Meta  *Meta
+}
+
+// Links manages the links returned from paginated API calls.
+type Links struct {
+	Pages *Pages `json:"pages,omitempty"`
+}
+
+// Pages contains the links for the current, previous, and next set of pages.
+type Pages struct {
+	First string `json:"first,omitempty"`
+	Prev  string `json:"prev,omitempty"`
+	Last  string `json:"last,omitempty"`
+	Next  string `json:"next,omitempty"`
+}
+
+// Meta holds pagination metadata about the response.
+type Meta struct {
+	Total int `json:"total,omitempty"`
+}
+
+// NewClient returns a new DigitalOcean API client.
+func NewClient(httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = &http.Client{}
+	}
+
+	baseURL, _ := url.Parse(defaultBaseURL)
+
+	c := &Client{
+		client:    httpClient,
+		BaseURL:   baseURL,
+		UserAgent: userAgent,
+	}
+
+	c.Registry = &RegistryServiceOp{client: c}
+
+	return c
+}
+
+// NewRequest creates an API request.
+func (c *Client) NewRequest(ctx context.Context, method, urlStr string, body interface{}) (*http.Request, error) {
+	rel, err := url.Parse(strings.TrimPrefix(urlStr, "/"))
+	if err != nil {
+		return nil, err
+	}
+
+	u := c.BaseURL.ResolveReference(rel)
+
+	var buf io.ReadWriter
+	if body != nil {
+		buf = new(bytes.Buffer)
+		if err := json.NewEncoder(buf).Encode(body); err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), buf)
+	if err != nil {
+		return nil, err
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", mediaType)
+	}
+	req.Header.Set("Accept", mediaType)
+	req.Header.Set("User-Agent", c.UserAgent)
+
+	return req, nil
+}
+
+// Do sends an API request and returns the API response, decoding the JSON
+// response body into v, or returning an error if one occurred.
+func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	httpResp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	resp := &Response{Response: httpResp}
+
+	if httpResp.StatusCode < 200 || httpResp.StatusCode >= 300 {
+		return resp, fmt.Errorf("request failed with status %d", httpResp.StatusCode)
+	}
+
+	switch v := v.(type) {
+	case nil:
+	case io.Writer:
+		_, err = io.Copy(v, httpResp.Body)
+	default:
+		err = json.NewDecoder(httpResp.Body).Decode(v)
+		if err == io.EOF {
+			err = nil
+		}
+	}
+
+	return resp, err
+}
+
+// addOptions adds the parameters in opts as URL query parameters to s. opts
+// must be a struct whose fields may contain "url" tags.
+func addOptions(s string, opts interface{}) (string, error) {
+	v := reflect.ValueOf(opts)
+	if v.Kind() == reflect.Ptr && v.IsNil() {
+		return s, nil
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return s, err
+	}
+
+	qs, err := query.Values(opts)
+	if err != nil {
+		return s, err
+	}
+
+	u.RawQuery = qs.Encode()
+	return u.String(), nil
+}
+
+// CurrentPage is current page of the list.
+func (r *Response) CurrentPage() (int, error) {
+	if r.Links == nil || r.Links.Pages == nil {
+		return 0, nil
+	}
+	return r.Links.Pages.currentPage()
+}
+
+func (p *Pages) currentPage() (int, error) {
+	pagesURL, offset := p.Next, -1
+	if pagesURL == "" {
+		pagesURL, offset = p.Prev, 1
+	}
+	if pagesURL == "" {
+		return 1, nil
+	}
+
+	u, err := url.Parse(pagesURL)
+	if err != nil {
+		return 0, err
+	}
+
+	page := u.Query().Get("page")
+	if page == "" {
+		return 1, nil
+	}
+
+	n, err := strconv.Atoi(page)
+	if err != nil {
+		return 0, err
+	}
+	return n + offset, nil
+}
+
+// NextPageToken parses the "page_token" query parameter from the next page
+// URL, returning an empty string once there are no more pages to fetch.
+func (l *Links) NextPageToken() (string, error) {
+	if l == nil || l.Pages == nil || l.Pages.Next == "" {
+		return "", nil
+	}
+
+	u, err := url.Parse(l.Pages.Next)
+	if err != nil {
+		return "", err
+	}
+
+	return u.Query().Get("page_token"), nil
+}
@@ -0,0 +1,54 @@
+package godo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+var (
+	mux    *http.ServeMux
+	ctx    = context.Background()
+	client *Client
+	server *httptest.Server
+)
+
+func setup() {
+	mux = http.NewServeMux()
+
+	server = httptest.NewServer(mux)
+
+	client = NewClient(nil)
+	u, _ := url.Parse(server.URL)
+	client.BaseURL = u
+}
+
+func teardown() {
+	server.Close()
+}
+
+func testMethod(t *testing.T, r *http.Request, want string) {
+	t.Helper()
+	if got := r.Method; got != want {
+		t.Errorf("Request method: %v, want %v", got, want)
+	}
+}
+
+func testFormValues(t *testing.T, r *http.Request, values map[string]string) {
+	t.Helper()
+	want := url.Values{}
+	for k, v := range values {
+		want.Set(k, v)
+	}
+
+	r.ParseForm()
+	if got := r.Form; got.Encode() != want.Encode() {
+		t.Errorf("Request parameters: %v, want %v", got, want)
+	}
+}
+
+func intPtr(i int) *int {
+	return &i
+}
@@ -0,0 +1,1235 @@
+package godo
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	registryPath                      = "/v2/registry"
+	registryDockerCredentialsPath     = registryPath + "/docker-credentials"
+	repositoriesPath                  = registryPath + "/%s/repositories"
+	repositoriesV2Path                = registryPath + "/%s/repositoriesV2"
+	registryManifestsPath             = registryPath + "/%s/repositories/%s/digests"
+	repositoryTagsPath                = registryPath + "/%s/repositories/%s/tags"
+	repositoryTagPath                 = registryPath + "/%s/repositories/%s/tags/%s"
+	repositoryManifestPath            = registryPath + "/%s/repositories/%s/digests/%s"
+	registryGarbageCollectionsPath    = registryPath + "/%s/garbage-collections"
+	registryGarbageCollectionPath     = registryPath + "/%s/garbage-collection"
+	registryGarbageCollectionByIDPath = registryPath + "/%s/garbage-collection/%s"
+	registryRetentionPoliciesPath     = registryPath + "/%s/retention-policies"
+	registryRetentionPolicyPath       = registryPath + "/%s/retention-policies/%s"
+	registryRetentionPolicyDryRunPath = registryPath + "/%s/retention-policies/dry-run"
+	repositoryTagScanPath             = registryPath + "/%s/repositories/%s/tags/%s/scan"
+	repositoryScanReportPath          = registryPath + "/%s/repositories/%s/digests/%s/scan"
+	repositoryVulnerabilitiesPath     = registryPath + "/%s/repositories/%s/digests/%s/vulnerabilities"
+	registryWebhooksPath              = registryPath + "/%s/webhooks"
+	registryWebhookPath               = registryPath + "/%s/webhooks/%s"
+	registryWebhookPingPath           = registryPath + "/%s/webhooks/%s/ping"
+	registryWebhookDeliveriesPath     = registryPath + "/%s/webhooks/%s/deliveries"
+)
+
+// RegistryService is an interface for interfacing with the registry endpoints
+// of the DigitalOcean API.
+type RegistryService interface {
+	Create(context.Context, *RegistryCreateRequest) (*Registry, *Response, error)
+	Get(context.Context) (*Registry, *Response, error)
+	Delete(context.Context) (*Response, error)
+	DockerCredentials(context.Context, *RegistryDockerCredentialsRequest) (*DockerCredentials, *Response, error)
+	ListRepositories(ctx context.Context, registry string, opts *ListOptions) ([]*Repository, *Response, error)
+	ListRepositoriesV2(ctx context.Context, registry string, opts *TokenListOptions) ([]*RepositoryV2, *Response, error)
+	ListRegistryManifests(ctx context.Context, registry, repository string, opts *TokenListOptions) ([]*RegistryManifest, *Response, error)
+	GetManifest(ctx context.Context, registry, repository, digest string) (*Manifest, *Response, error)
+	ListRepositoryTags(ctx context.Context, registry, repository string, opts *ListOptions) ([]*RepositoryTag, *Response, error)
+	DeleteTag(ctx context.Context, registry, repository, tag string) (*Response, error)
+	DeleteManifest(ctx context.Context, registry, repository, digest string) (*Response, error)
+	DeleteManifestPlatform(ctx context.Context, registry, repository, digest string, platform *ManifestPlatform) (*Response, error)
+	StartGarbageCollection(ctx context.Context, registry string) (*GarbageCollection, *Response, error)
+	GetGarbageCollection(ctx context.Context, registry string) (*GarbageCollection, *Response, error)
+	ListGarbageCollections(ctx context.Context, registry string, opts *ListOptions) ([]*GarbageCollection, *Response, error)
+	UpdateGarbageCollection(ctx context.Context, registry, uuid string, req *UpdateGarbageCollectionRequest) (*GarbageCollection, *Response, error)
+	CreateRetentionPolicy(ctx context.Context, registry string, create *RetentionPolicyRequest) (*RetentionPolicy, *Response, error)
+	ListRetentionPolicies(ctx context.Context, registry string, opts *ListOptions) ([]*RetentionPolicy, *Response, error)
+	UpdateRetentionPolicy(ctx context.Context, registry, uuid string, update *RetentionPolicyRequest) (*RetentionPolicy, *Response, error)
+	DeleteRetentionPolicy(ctx context.Context, registry, uuid string) (*Response, error)
+	DryRunRetentionPolicy(ctx context.Context, registry string, policy *RetentionPolicyRequest) (*RetentionDryRun, *Response, error)
+	ScanTag(ctx context.Context, registry, repository, tag string) (*ScanReport, *Response, error)
+	GetScanReport(ctx context.Context, registry, repository, digest string) (*ScanReport, *Response, error)
+	ListVulnerabilities(ctx context.Context, registry, repository, digest string, opts *ListOptions) ([]*Vulnerability, *Response, error)
+	CreateWebhook(ctx context.Context, registry string, create *RegistryWebhookRequest) (*RegistryWebhook, *Response, error)
+	ListWebhooks(ctx context.Context, registry string, opts *ListOptions) ([]*RegistryWebhook, *Response, error)
+	GetWebhook(ctx context.Context, registry, webhookID string) (*RegistryWebhook, *Response, error)
+	UpdateWebhook(ctx context.Context, registry, webhookID string, update *RegistryWebhookRequest) (*RegistryWebhook, *Response, error)
+	DeleteWebhook(ctx context.Context, registry, webhookID string) (*Response, error)
+	PingWebhook(ctx context.Context, registry, webhookID string) (*Response, error)
+	ListWebhookDeliveries(ctx context.Context, registry, webhookID string, opts *ListOptions) ([]*RegistryWebhookDelivery, *Response, error)
+	BulkDeleteTags(ctx context.Context, registry, repository string, tags []string, opts *BulkDeleteOptions) (*BulkDeleteResult, *Response, error)
+	BulkDeleteManifests(ctx context.Context, registry, repository string, digests []string, opts *BulkDeleteOptions) (*BulkDeleteResult, *Response, error)
+}
+
+// RegistryServiceOp handles communication with the registry related methods
+// of the DigitalOcean API.
+type RegistryServiceOp struct {
+	client *Client
+}
+
+var _ RegistryService = &RegistryServiceOp{}
+
+// Registry represents a DigitalOcean container registry.
+type Registry struct {
+	Name      string    `json:"name,omitempty"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// RegistryCreateRequest represents a request to create a registry.
+type RegistryCreateRequest struct {
+	Name string `json:"name,omitempty"`
+}
+
+// RegistryDockerCredentialsRequest represents a request to retrieve docker
+// credentials for a registry.
+type RegistryDockerCredentialsRequest struct {
+	ReadWrite     bool `json:"read_write"`
+	ExpirySeconds *int `json:"expiry_seconds,omitempty"`
+}
+
+// DockerCredentials is the Docker config.json content that can be used to
+// authenticate with the registry.
+type DockerCredentials struct {
+	DockerConfigJSON []byte
+}
+
+// Repository represents a repository within a registry.
+type Repository struct {
+	RegistryName string         `json:"registry_name,omitempty"`
+	Name         string         `json:"name,omitempty"`
+	TagCount     uint64         `json:"tag_count,omitempty"`
+	LatestTag    *RepositoryTag `json:"latest_tag,omitempty"`
+}
+
+// RepositoryTag represents a tag in a repository.
+type RepositoryTag struct {
+	RegistryName        string    `json:"registry_name,omitempty"`
+	Repository          string    `json:"repository,omitempty"`
+	Tag                 string    `json:"tag,omitempty"`
+	ManifestDigest      string    `json:"manifest_digest,omitempty"`
+	CompressedSizeBytes uint64    `json:"compressed_size_bytes,omitempty"`
+	SizeBytes           uint64    `json:"size_bytes,omitempty"`
+	UpdatedAt           time.Time `json:"updated_at,omitempty"`
+}
+
+// RepositoryV2 represents a repository within a registry, as returned by the
+// v2 repositories listing which reports manifest and tag counts directly
+// instead of a single latest tag.
+type RepositoryV2 struct {
+	RegistryName   string            `json:"registry_name,omitempty"`
+	Name           string            `json:"name,omitempty"`
+	TagCount       uint64            `json:"tag_count,omitempty"`
+	ManifestCount  uint64            `json:"manifest_count,omitempty"`
+	LatestManifest *RegistryManifest `json:"latest_manifest,omitempty"`
+}
+
+// RegistryManifest represents a manifest for a given repository within a
+// registry, matching the shape of the container registry v2 API.
+type RegistryManifest struct {
+	RegistryName        string          `json:"registry_name,omitempty"`
+	Repository          string          `json:"repository,omitempty"`
+	Digest              string          `json:"digest,omitempty"`
+	CompressedSizeBytes uint64          `json:"compressed_size_bytes,omitempty"`
+	SizeBytes           uint64          `json:"size_bytes,omitempty"`
+	UpdatedAt           time.Time       `json:"updated_at,omitempty"`
+	Tags                []string        `json:"tags,omitempty"`
+	Blobs               []*RegistryBlob `json:"blobs,omitempty"`
+	MediaType           string          `json:"media_type,omitempty"`
+}
+
+// RegistryBlob represents a content-addressable blob referenced by a
+// manifest.
+type RegistryBlob struct {
+	Digest    string `json:"digest,omitempty"`
+	SizeBytes uint64 `json:"size_bytes,omitempty"`
+	MediaType string `json:"media_type,omitempty"`
+}
+
+// Manifest represents a manifest within a repository, which may itself be a
+// multi-architecture manifest list pointing at one child manifest per
+// platform.
+type Manifest struct {
+	RegistryName string               `json:"registry_name,omitempty"`
+	Repository   string               `json:"repository,omitempty"`
+	MediaType    string               `json:"media_type,omitempty"`
+	Digest       string               `json:"digest,omitempty"`
+	SizeBytes    uint64               `json:"size_bytes,omitempty"`
+	Platform     *ManifestPlatform    `json:"platform,omitempty"`
+	References   []*ManifestReference `json:"references,omitempty"`
+}
+
+// ManifestReference represents a single child manifest referenced from a
+// manifest list, as pushed by tools like `docker buildx`.
+type ManifestReference struct {
+	Digest    string            `json:"digest,omitempty"`
+	MediaType string            `json:"media_type,omitempty"`
+	SizeBytes uint64            `json:"size_bytes,omitempty"`
+	Platform  *ManifestPlatform `json:"platform,omitempty"`
+}
+
+// ManifestPlatform identifies the platform a manifest or manifest reference
+// was built for.
+type ManifestPlatform struct {
+	OS           string `json:"os,omitempty"`
+	Architecture string `json:"architecture,omitempty"`
+	Variant      string `json:"variant,omitempty"`
+}
+
+// GarbageCollection represents a garbage collection for a registry.
+type GarbageCollection struct {
+	UUID         string    `json:"uuid,omitempty"`
+	RegistryName string    `json:"registry_name,omitempty"`
+	Status       string    `json:"status,omitempty"`
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	BlobsDeleted uint64    `json:"blobs_deleted,omitempty"`
+	FreedBytes   uint64    `json:"freed_bytes,omitempty"`
+}
+
+// UpdateGarbageCollectionRequest represents a request to update a garbage
+// collection.
+type UpdateGarbageCollectionRequest struct {
+	Cancel bool `json:"cancel,omitempty"`
+}
+
+// RetentionScheduleFrequency is how often a retention policy runs when it is
+// not driven by an explicit cron expression.
+type RetentionScheduleFrequency string
+
+const (
+	// RetentionScheduleDaily runs a retention policy once a day.
+	RetentionScheduleDaily RetentionScheduleFrequency = "daily"
+	// RetentionScheduleWeekly runs a retention policy once a week.
+	RetentionScheduleWeekly RetentionScheduleFrequency = "weekly"
+)
+
+// RetentionSchedule controls when a retention policy is evaluated. Either
+// Cron or Frequency should be set; Cron takes precedence when both are
+// present.
+type RetentionSchedule struct {
+	Cron      string                     `json:"cron,omitempty"`
+	Frequency RetentionScheduleFrequency `json:"frequency,omitempty"`
+}
+
+// RetentionRules describes which tags and manifests a retention policy keeps.
+type RetentionRules struct {
+	KeepLastN            int      `json:"keep_last_n,omitempty"`
+	KeepNewerThanSeconds int64    `json:"keep_newer_than_seconds,omitempty"`
+	KeepMatchingTags     []string `json:"keep_matching_tags,omitempty"`
+	AlwaysKeepDigests    []string `json:"always_keep_digests,omitempty"`
+}
+
+// RetentionPolicyRequest represents a request to create, update, or dry-run a
+// tag retention policy.
+type RetentionPolicyRequest struct {
+	Name         string            `json:"name,omitempty"`
+	Repositories []string          `json:"repositories,omitempty"`
+	Rules        RetentionRules    `json:"rules"`
+	Schedule     RetentionSchedule `json:"schedule"`
+	Enabled      bool              `json:"enabled"`
+}
+
+// RetentionPolicy represents a tag retention policy for a registry, modeled
+// after Harbor's tag retention rules.
+type RetentionPolicy struct {
+	UUID         string            `json:"uuid,omitempty"`
+	RegistryName string            `json:"registry_name,omitempty"`
+	Name         string            `json:"name,omitempty"`
+	Repositories []string          `json:"repositories,omitempty"`
+	Rules        RetentionRules    `json:"rules"`
+	Schedule     RetentionSchedule `json:"schedule"`
+	Enabled      bool              `json:"enabled"`
+	CreatedAt    time.Time         `json:"created_at,omitempty"`
+	UpdatedAt    time.Time         `json:"updated_at,omitempty"`
+}
+
+// RetentionDryRun previews the effect of a retention policy without deleting
+// anything, so users can validate a policy before enabling it.
+type RetentionDryRun struct {
+	Tags                []*RepositoryTag    `json:"tags,omitempty"`
+	Manifests           []*RegistryManifest `json:"manifests,omitempty"`
+	EstimatedFreedBytes uint64              `json:"estimated_freed_bytes,omitempty"`
+}
+
+// Severity is the severity of a vulnerability found during a tag scan.
+type Severity string
+
+const (
+	SeverityUnknown  Severity = "unknown"
+	SeverityLow      Severity = "low"
+	SeverityMedium   Severity = "medium"
+	SeverityHigh     Severity = "high"
+	SeverityCritical Severity = "critical"
+)
+
+var severityRank = map[Severity]int{
+	SeverityUnknown:  0,
+	SeverityLow:      1,
+	SeverityMedium:   2,
+	SeverityHigh:     3,
+	SeverityCritical: 4,
+}
+
+// ScanStatus is the current state of a vulnerability scan.
+type ScanStatus string
+
+const (
+	ScanStatusQueued   ScanStatus = "queued"
+	ScanStatusRunning  ScanStatus = "running"
+	ScanStatusComplete ScanStatus = "complete"
+	ScanStatusFailed   ScanStatus = "failed"
+)
+
+// VulnerabilitySummary is a count of vulnerabilities found in a scan, broken
+// down by severity.
+type VulnerabilitySummary struct {
+	Critical int `json:"critical,omitempty"`
+	High     int `json:"high,omitempty"`
+	Medium   int `json:"medium,omitempty"`
+	Low      int `json:"low,omitempty"`
+	Unknown  int `json:"unknown,omitempty"`
+}
+
+// Vulnerability represents a single vulnerability found in an image layer,
+// modeled after Clair/Trivy-style scan results.
+type Vulnerability struct {
+	CVE              string   `json:"cve,omitempty"`
+	Severity         Severity `json:"severity,omitempty"`
+	Package          string   `json:"package,omitempty"`
+	InstalledVersion string   `json:"installed_version,omitempty"`
+	FixedVersion     string   `json:"fixed_version,omitempty"`
+	Layer            string   `json:"layer,omitempty"`
+	Description      string   `json:"description,omitempty"`
+	Links            []string `json:"links,omitempty"`
+}
+
+// ScanReport represents the result of a vulnerability scan of a single image
+// digest.
+type ScanReport struct {
+	RegistryName    string               `json:"registry_name,omitempty"`
+	Repository      string               `json:"repository,omitempty"`
+	Digest          string               `json:"digest,omitempty"`
+	Status          ScanStatus           `json:"status,omitempty"`
+	StartedAt       time.Time            `json:"started_at,omitempty"`
+	CompletedAt     time.Time            `json:"completed_at,omitempty"`
+	Summary         VulnerabilitySummary `json:"summary"`
+	Vulnerabilities []*Vulnerability     `json:"vulnerabilities,omitempty"`
+}
+
+// HasBlockingVulnerabilities reports whether the scan found any
+// vulnerability at or above minSeverity, so CI pipelines can fail the build
+// on the result of a scan.
+func (r *ScanReport) HasBlockingVulnerabilities(minSeverity Severity) bool {
+	threshold := severityRank[minSeverity]
+
+	counts := map[Severity]int{
+		SeverityCritical: r.Summary.Critical,
+		SeverityHigh:     r.Summary.High,
+		SeverityMedium:   r.Summary.Medium,
+		SeverityLow:      r.Summary.Low,
+		SeverityUnknown:  r.Summary.Unknown,
+	}
+
+	for severity, count := range counts {
+		if count > 0 && severityRank[severity] >= threshold {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RegistryWebhookRetryPolicy controls how delivery retries are attempted when
+// a webhook consumer fails to accept an event.
+type RegistryWebhookRetryPolicy struct {
+	MaxAttempts    int `json:"max_attempts,omitempty"`
+	BackoffSeconds int `json:"backoff_seconds,omitempty"`
+}
+
+// RegistryWebhookRequest represents a request to create or update a registry
+// webhook subscription.
+type RegistryWebhookRequest struct {
+	Name         string                      `json:"name,omitempty"`
+	TargetURL    string                      `json:"target_url,omitempty"`
+	Secret       string                      `json:"secret,omitempty"`
+	EventTypes   []string                    `json:"event_types,omitempty"`
+	Repositories []string                    `json:"repositories,omitempty"`
+	Enabled      bool                        `json:"enabled"`
+	Retry        *RegistryWebhookRetryPolicy `json:"retry,omitempty"`
+}
+
+// RegistryWebhook represents a webhook subscription that notifies an
+// external URL of registry events, mirroring the notification event model
+// documented in the docker/distribution registry spec.
+type RegistryWebhook struct {
+	UUID         string                      `json:"uuid,omitempty"`
+	RegistryName string                      `json:"registry_name,omitempty"`
+	Name         string                      `json:"name,omitempty"`
+	TargetURL    string                      `json:"target_url,omitempty"`
+	EventTypes   []string                    `json:"event_types,omitempty"`
+	Repositories []string                    `json:"repositories,omitempty"`
+	Enabled      bool                        `json:"enabled"`
+	Retry        *RegistryWebhookRetryPolicy `json:"retry,omitempty"`
+	CreatedAt    time.Time                   `json:"created_at,omitempty"`
+	UpdatedAt    time.Time                   `json:"updated_at,omitempty"`
+}
+
+// RegistryWebhookDelivery represents a single delivery attempt of an event to
+// a webhook's target URL.
+type RegistryWebhookDelivery struct {
+	UUID                string    `json:"uuid,omitempty"`
+	WebhookUUID         string    `json:"webhook_uuid,omitempty"`
+	EventType           string    `json:"event_type,omitempty"`
+	StatusCode          int       `json:"status_code,omitempty"`
+	DurationMillis      int64     `json:"duration_millis,omitempty"`
+	ResponseBodySnippet string    `json:"response_body_snippet,omitempty"`
+	Success             bool      `json:"success"`
+	DeliveredAt         time.Time `json:"delivered_at,omitempty"`
+}
+
+// BulkDeleteOptions controls how BulkDeleteTags and BulkDeleteManifests fan
+// out their work.
+type BulkDeleteOptions struct {
+	// Concurrency bounds the number of in-flight DELETE requests. A value
+	// <= 0 is treated as 1.
+	Concurrency int
+	// ContinueOnError, when false, stops dispatching further deletes as
+	// soon as one fails; items already in flight are allowed to finish.
+	ContinueOnError bool
+}
+
+// BulkDeleteItemError records why a single item failed to delete as part of
+// a bulk operation.
+type BulkDeleteItemError struct {
+	Item  string `json:"item,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+// BulkDeleteResult is the outcome of a BulkDeleteTags or BulkDeleteManifests
+// call.
+type BulkDeleteResult struct {
+	Succeeded []string               `json:"succeeded,omitempty"`
+	Failed    []*BulkDeleteItemError `json:"failed,omitempty"`
+}
+
+type registryRoot struct {
+	Registry *Registry `json:"registry,omitempty"`
+}
+
+type repositoriesRoot struct {
+	Repositories []*Repository `json:"repositories,omitempty"`
+	Links        *Links        `json:"links,omitempty"`
+	Meta         *Meta         `json:"meta"`
+}
+
+type repositoriesV2Root struct {
+	Repositories []*RepositoryV2 `json:"repositories,omitempty"`
+	Links        *Links          `json:"links,omitempty"`
+	Meta         *Meta           `json:"meta"`
+}
+
+type registryManifestsRoot struct {
+	Manifests []*RegistryManifest `json:"manifests,omitempty"`
+	Links     *Links              `json:"links,omitempty"`
+	Meta      *Meta               `json:"meta"`
+}
+
+type manifestRoot struct {
+	Manifest *Manifest `json:"manifest,omitempty"`
+}
+
+type repositoryTagsRoot struct {
+	Tags  []*RepositoryTag `json:"tags,omitempty"`
+	Links *Links           `json:"links,omitempty"`
+	Meta  *Meta            `json:"meta"`
+}
+
+type garbageCollectionRoot struct {
+	GarbageCollection *GarbageCollection `json:"garbage_collection,omitempty"`
+}
+
+type garbageCollectionsRoot struct {
+	GarbageCollections []*GarbageCollection `json:"garbage_collections,omitempty"`
+	Links              *Links               `json:"links,omitempty"`
+	Meta               *Meta                `json:"meta"`
+}
+
+type retentionPolicyRoot struct {
+	RetentionPolicy *RetentionPolicy `json:"retention_policy,omitempty"`
+}
+
+type retentionPoliciesRoot struct {
+	RetentionPolicies []*RetentionPolicy `json:"retention_policies,omitempty"`
+	Links             *Links             `json:"links,omitempty"`
+	Meta              *Meta              `json:"meta"`
+}
+
+type retentionDryRunRoot struct {
+	RetentionDryRun *RetentionDryRun `json:"retention_dry_run,omitempty"`
+}
+
+type scanReportRoot struct {
+	ScanReport *ScanReport `json:"scan_report,omitempty"`
+}
+
+type vulnerabilitiesRoot struct {
+	Vulnerabilities []*Vulnerability `json:"vulnerabilities,omitempty"`
+	Links           *Links           `json:"links,omitempty"`
+	Meta            *Meta            `json:"meta"`
+}
+
+type registryWebhookRoot struct {
+	Webhook *RegistryWebhook `json:"webhook,omitempty"`
+}
+
+type registryWebhooksRoot struct {
+	Webhooks []*RegistryWebhook `json:"webhooks,omitempty"`
+	Links    *Links             `json:"links,omitempty"`
+	Meta     *Meta              `json:"meta"`
+}
+
+type registryWebhookDeliveriesRoot struct {
+	Deliveries []*RegistryWebhookDelivery `json:"deliveries,omitempty"`
+	Links      *Links                     `json:"links,omitempty"`
+	Meta       *Meta                      `json:"meta"`
+}
+
+// Create creates a registry for the authenticated account.
+func (svc *RegistryServiceOp) Create(ctx context.Context, create *RegistryCreateRequest) (*Registry, *Response, error) {
+	req, err := svc.client.NewRequest(ctx, http.MethodPost, registryPath, create)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(registryRoot)
+	resp, err := svc.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Registry, resp, nil
+}
+
+// Get retrieves the registry for the authenticated account.
+func (svc *RegistryServiceOp) Get(ctx context.Context) (*Registry, *Response, error) {
+	req, err := svc.client.NewRequest(ctx, http.MethodGet, registryPath, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(registryRoot)
+	resp, err := svc.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Registry, resp, nil
+}
+
+// Delete deletes the registry for the authenticated account.
+func (svc *RegistryServiceOp) Delete(ctx context.Context) (*Response, error) {
+	req, err := svc.client.NewRequest(ctx, http.MethodDelete, registryPath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return svc.client.Do(ctx, req, nil)
+}
+
+// DockerCredentials retrieves a Docker config.json that can be used to
+// authenticate with the registry.
+func (svc *RegistryServiceOp) DockerCredentials(ctx context.Context, request *RegistryDockerCredentialsRequest) (*DockerCredentials, *Response, error) {
+	path := registryDockerCredentialsPath
+
+	u := url.Values{}
+	if request.ReadWrite {
+		u.Set("read_write", "true")
+	} else {
+		u.Set("read_write", "false")
+	}
+	if request.ExpirySeconds != nil {
+		u.Set("expiry_seconds", fmt.Sprintf("%d", *request.ExpirySeconds))
+	}
+	path = fmt.Sprintf("%s?%s", path, u.Encode())
+
+	req, err := svc.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var buf bytes.Buffer
+	resp, err := svc.client.Do(ctx, req, &buf)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &DockerCredentials{DockerConfigJSON: buf.Bytes()}, resp, nil
+}
+
+// ListRepositories lists the repositories in a registry.
+func (svc *RegistryServiceOp) ListRepositories(ctx context.Context, registry string, opts *ListOptions) ([]*Repository, *Response, error) {
+	path := fmt.Sprintf(repositoriesPath, registry)
+	path, err := addOptions(path, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := svc.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(repositoriesRoot)
+	resp, err := svc.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if l := root.Links; l != nil {
+		resp.Links = l
+	}
+	if m := root.Meta; m != nil {
+		resp.Meta = m
+	}
+
+	return root.Repositories, resp, nil
+}
+
+// ListRepositoriesV2 lists the repositories in a registry, using token-based
+// pagination. Callers should loop, passing the token returned by
+// Response.Links.NextPageToken back in as opts.Page, until it comes back
+// empty.
+func (svc *RegistryServiceOp) ListRepositoriesV2(ctx context.Context, registry string, opts *TokenListOptions) ([]*RepositoryV2, *Response, error) {
+	path := fmt.Sprintf(repositoriesV2Path, registry)
+	path, err := addOptions(path, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := svc.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(repositoriesV2Root)
+	resp, err := svc.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if l := root.Links; l != nil {
+		resp.Links = l
+	}
+	if m := root.Meta; m != nil {
+		resp.Meta = m
+	}
+
+	return root.Repositories, resp, nil
+}
+
+// ListRegistryManifests lists the manifests for a repository, using
+// token-based pagination. Callers should loop, passing the token returned by
+// Response.Links.NextPageToken back in as opts.Page, until it comes back
+// empty.
+func (svc *RegistryServiceOp) ListRegistryManifests(ctx context.Context, registry, repository string, opts *TokenListOptions) ([]*RegistryManifest, *Response, error) {
+	path := fmt.Sprintf(registryManifestsPath, registry, url.PathEscape(repository))
+	path, err := addOptions(path, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := svc.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(registryManifestsRoot)
+	resp, err := svc.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if l := root.Links; l != nil {
+		resp.Links = l
+	}
+	if m := root.Meta; m != nil {
+		resp.Meta = m
+	}
+
+	return root.Manifests, resp, nil
+}
+
+// ListRepositoryTags lists the tags in a repository.
+func (svc *RegistryServiceOp) ListRepositoryTags(ctx context.Context, registry, repository string, opts *ListOptions) ([]*RepositoryTag, *Response, error) {
+	path := fmt.Sprintf(repositoryTagsPath, registry, url.PathEscape(repository))
+	path, err := addOptions(path, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := svc.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(repositoryTagsRoot)
+	resp, err := svc.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if l := root.Links; l != nil {
+		resp.Links = l
+	}
+	if m := root.Meta; m != nil {
+		resp.Meta = m
+	}
+
+	return root.Tags, resp, nil
+}
+
+// DeleteTag deletes a tag within a repository.
+func (svc *RegistryServiceOp) DeleteTag(ctx context.Context, registry, repository, tag string) (*Response, error) {
+	path := fmt.Sprintf(repositoryTagPath, registry, url.PathEscape(repository), tag)
+	req, err := svc.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return svc.client.Do(ctx, req, nil)
+}
+
+// DeleteManifest deletes a manifest within a repository.
+func (svc *RegistryServiceOp) DeleteManifest(ctx context.Context, registry, repository, digest string) (*Response, error) {
+	path := fmt.Sprintf(repositoryManifestPath, registry, url.PathEscape(repository), digest)
+	req, err := svc.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return svc.client.Do(ctx, req, nil)
+}
+
+// GetManifest retrieves a single manifest within a repository. If the
+// manifest is a multi-architecture manifest list (e.g. one pushed by `docker
+// buildx`), References will be populated with one entry per platform.
+func (svc *RegistryServiceOp) GetManifest(ctx context.Context, registry, repository, digest string) (*Manifest, *Response, error) {
+	path := fmt.Sprintf(repositoryManifestPath, registry, url.PathEscape(repository), digest)
+	req, err := svc.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(manifestRoot)
+	resp, err := svc.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Manifest, resp, nil
+}
+
+// DeleteManifestPlatform removes a single platform variant from the manifest
+// list stored at digest, leaving the other platform variants and the tag
+// pointing at the list intact. If platform is the last remaining reference,
+// the manifest list itself is deleted. This lets callers prune e.g. a stray
+// linux/arm64 build without nuking the whole multi-arch tag.
+func (svc *RegistryServiceOp) DeleteManifestPlatform(ctx context.Context, registry, repository, digest string, platform *ManifestPlatform) (*Response, error) {
+	if platform == nil {
+		return nil, fmt.Errorf("platform must not be nil")
+	}
+
+	manifest, resp, err := svc.GetManifest(ctx, registry, repository, digest)
+	if err != nil {
+		return resp, err
+	}
+
+	if len(manifest.References) == 0 {
+		return nil, fmt.Errorf("manifest %s is not a manifest list", digest)
+	}
+
+	remaining := make([]*ManifestReference, 0, len(manifest.References))
+	found := false
+	for _, ref := range manifest.References {
+		if platformsEqual(ref.Platform, platform) {
+			found = true
+			continue
+		}
+		remaining = append(remaining, ref)
+	}
+
+	if !found {
+		return nil, fmt.Errorf("manifest %s has no child for platform %s/%s", digest, platform.OS, platform.Architecture)
+	}
+
+	if len(remaining) == 0 {
+		return svc.DeleteManifest(ctx, registry, repository, digest)
+	}
+
+	path := fmt.Sprintf(repositoryManifestPath, registry, url.PathEscape(repository), digest)
+	req, err := svc.client.NewRequest(ctx, http.MethodPut, path, &manifestRoot{
+		Manifest: &Manifest{
+			MediaType:  manifest.MediaType,
+			References: remaining,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return svc.client.Do(ctx, req, nil)
+}
+
+func platformsEqual(a, b *ManifestPlatform) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.OS == b.OS && a.Architecture == b.Architecture && a.Variant == b.Variant
+}
+
+// StartGarbageCollection starts a new garbage collection for a registry.
+func (svc *RegistryServiceOp) StartGarbageCollection(ctx context.Context, registry string) (*GarbageCollection, *Response, error) {
+	path := fmt.Sprintf(registryGarbageCollectionPath, registry)
+	req, err := svc.client.NewRequest(ctx, http.MethodPost, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(garbageCollectionRoot)
+	resp, err := svc.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.GarbageCollection, resp, nil
+}
+
+// GetGarbageCollection retrieves the currently active garbage collection for
+// a registry, if any.
+func (svc *RegistryServiceOp) GetGarbageCollection(ctx context.Context, registry string) (*GarbageCollection, *Response, error) {
+	path := fmt.Sprintf(registryGarbageCollectionPath, registry)
+	req, err := svc.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(garbageCollectionRoot)
+	resp, err := svc.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.GarbageCollection, resp, nil
+}
+
+// ListGarbageCollections lists the garbage collections for a registry.
+func (svc *RegistryServiceOp) ListGarbageCollections(ctx context.Context, registry string, opts *ListOptions) ([]*GarbageCollection, *Response, error) {
+	path := fmt.Sprintf(registryGarbageCollectionsPath, registry)
+	path, err := addOptions(path, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := svc.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(garbageCollectionsRoot)
+	resp, err := svc.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if l := root.Links; l != nil {
+		resp.Links = l
+	}
+	if m := root.Meta; m != nil {
+		resp.Meta = m
+	}
+
+	return root.GarbageCollections, resp, nil
+}
+
+// UpdateGarbageCollection updates (e.g. cancels) an active garbage collection
+// for a registry.
+func (svc *RegistryServiceOp) UpdateGarbageCollection(ctx context.Context, registry, uuid string, request *UpdateGarbageCollectionRequest) (*GarbageCollection, *Response, error) {
+	path := fmt.Sprintf(registryGarbageCollectionByIDPath, registry, uuid)
+	req, err := svc.client.NewRequest(ctx, http.MethodPut, path, request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(garbageCollectionRoot)
+	resp, err := svc.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.GarbageCollection, resp, nil
+}
+
+// CreateRetentionPolicy creates a tag retention policy for a registry.
+func (svc *RegistryServiceOp) CreateRetentionPolicy(ctx context.Context, registry string, create *RetentionPolicyRequest) (*RetentionPolicy, *Response, error) {
+	path := fmt.Sprintf(registryRetentionPoliciesPath, registry)
+	req, err := svc.client.NewRequest(ctx, http.MethodPost, path, create)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(retentionPolicyRoot)
+	resp, err := svc.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.RetentionPolicy, resp, nil
+}
+
+// ListRetentionPolicies lists the tag retention policies for a registry.
+func (svc *RegistryServiceOp) ListRetentionPolicies(ctx context.Context, registry string, opts *ListOptions) ([]*RetentionPolicy, *Response, error) {
+	path := fmt.Sprintf(registryRetentionPoliciesPath, registry)
+	path, err := addOptions(path, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := svc.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(retentionPoliciesRoot)
+	resp, err := svc.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if l := root.Links; l != nil {
+		resp.Links = l
+	}
+	if m := root.Meta; m != nil {
+		resp.Meta = m
+	}
+
+	return root.RetentionPolicies, resp, nil
+}
+
+// UpdateRetentionPolicy updates a tag retention policy for a registry.
+func (svc *RegistryServiceOp) UpdateRetentionPolicy(ctx context.Context, registry, uuid string, update *RetentionPolicyRequest) (*RetentionPolicy, *Response, error) {
+	path := fmt.Sprintf(registryRetentionPolicyPath, registry, uuid)
+	req, err := svc.client.NewRequest(ctx, http.MethodPut, path, update)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(retentionPolicyRoot)
+	resp, err := svc.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.RetentionPolicy, resp, nil
+}
+
+// DeleteRetentionPolicy deletes a tag retention policy for a registry.
+func (svc *RegistryServiceOp) DeleteRetentionPolicy(ctx context.Context, registry, uuid string) (*Response, error) {
+	path := fmt.Sprintf(registryRetentionPolicyPath, registry, uuid)
+	req, err := svc.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return svc.client.Do(ctx, req, nil)
+}
+
+// DryRunRetentionPolicy previews the tags and manifests that policy would
+// delete without actually deleting anything, so users can validate a policy
+// before enabling it.
+func (svc *RegistryServiceOp) DryRunRetentionPolicy(ctx context.Context, registry string, policy *RetentionPolicyRequest) (*RetentionDryRun, *Response, error) {
+	path := fmt.Sprintf(registryRetentionPolicyDryRunPath, registry)
+	req, err := svc.client.NewRequest(ctx, http.MethodPost, path, policy)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(retentionDryRunRoot)
+	resp, err := svc.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.RetentionDryRun, resp, nil
+}
+
+// ScanTag kicks off a vulnerability scan of the image a tag points at.
+func (svc *RegistryServiceOp) ScanTag(ctx context.Context, registry, repository, tag string) (*ScanReport, *Response, error) {
+	path := fmt.Sprintf(repositoryTagScanPath, registry, url.PathEscape(repository), tag)
+	req, err := svc.client.NewRequest(ctx, http.MethodPost, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(scanReportRoot)
+	resp, err := svc.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.ScanReport, resp, nil
+}
+
+// GetScanReport retrieves the vulnerability scan report for an image digest.
+func (svc *RegistryServiceOp) GetScanReport(ctx context.Context, registry, repository, digest string) (*ScanReport, *Response, error) {
+	path := fmt.Sprintf(repositoryScanReportPath, registry, url.PathEscape(repository), digest)
+	req, err := svc.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(scanReportRoot)
+	resp, err := svc.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.ScanReport, resp, nil
+}
+
+// ListVulnerabilities lists the vulnerabilities found in an image digest.
+func (svc *RegistryServiceOp) ListVulnerabilities(ctx context.Context, registry, repository, digest string, opts *ListOptions) ([]*Vulnerability, *Response, error) {
+	path := fmt.Sprintf(repositoryVulnerabilitiesPath, registry, url.PathEscape(repository), digest)
+	path, err := addOptions(path, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := svc.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(vulnerabilitiesRoot)
+	resp, err := svc.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if l := root.Links; l != nil {
+		resp.Links = l
+	}
+	if m := root.Meta; m != nil {
+		resp.Meta = m
+	}
+
+	return root.Vulnerabilities, resp, nil
+}
+
+// CreateWebhook creates a webhook subscription for a registry.
+func (svc *RegistryServiceOp) CreateWebhook(ctx context.Context, registry string, create *RegistryWebhookRequest) (*RegistryWebhook, *Response, error) {
+	path := fmt.Sprintf(registryWebhooksPath, registry)
+	req, err := svc.client.NewRequest(ctx, http.MethodPost, path, create)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(registryWebhookRoot)
+	resp, err := svc.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Webhook, resp, nil
+}
+
+// ListWebhooks lists the webhook subscriptions for a registry.
+func (svc *RegistryServiceOp) ListWebhooks(ctx context.Context, registry string, opts *ListOptions) ([]*RegistryWebhook, *Response, error) {
+	path := fmt.Sprintf(registryWebhooksPath, registry)
+	path, err := addOptions(path, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := svc.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(registryWebhooksRoot)
+	resp, err := svc.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if l := root.Links; l != nil {
+		resp.Links = l
+	}
+	if m := root.Meta; m != nil {
+		resp.Meta = m
+	}
+
+	return root.Webhooks, resp, nil
+}
+
+// GetWebhook retrieves a single webhook subscription for a registry.
+func (svc *RegistryServiceOp) GetWebhook(ctx context.Context, registry, webhookID string) (*RegistryWebhook, *Response, error) {
+	path := fmt.Sprintf(registryWebhookPath, registry, webhookID)
+	req, err := svc.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(registryWebhookRoot)
+	resp, err := svc.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Webhook, resp, nil
+}
+
+// UpdateWebhook updates a webhook subscription for a registry.
+func (svc *RegistryServiceOp) UpdateWebhook(ctx context.Context, registry, webhookID string, update *RegistryWebhookRequest) (*RegistryWebhook, *Response, error) {
+	path := fmt.Sprintf(registryWebhookPath, registry, webhookID)
+	req, err := svc.client.NewRequest(ctx, http.MethodPut, path, update)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(registryWebhookRoot)
+	resp, err := svc.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root.Webhook, resp, nil
+}
+
+// DeleteWebhook deletes a webhook subscription for a registry.
+func (svc *RegistryServiceOp) DeleteWebhook(ctx context.Context, registry, webhookID string) (*Response, error) {
+	path := fmt.Sprintf(registryWebhookPath, registry, webhookID)
+	req, err := svc.client.NewRequest(ctx, http.MethodDelete, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return svc.client.Do(ctx, req, nil)
+}
+
+// PingWebhook sends a test delivery to a webhook's target URL.
+func (svc *RegistryServiceOp) PingWebhook(ctx context.Context, registry, webhookID string) (*Response, error) {
+	path := fmt.Sprintf(registryWebhookPingPath, registry, webhookID)
+	req, err := svc.client.NewRequest(ctx, http.MethodPost, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return svc.client.Do(ctx, req, nil)
+}
+
+// ListWebhookDeliveries lists the delivery attempts for a webhook, so users
+// can debug failing consumers.
+func (svc *RegistryServiceOp) ListWebhookDeliveries(ctx context.Context, registry, webhookID string, opts *ListOptions) ([]*RegistryWebhookDelivery, *Response, error) {
+	path := fmt.Sprintf(registryWebhookDeliveriesPath, registry, webhookID)
+	path, err := addOptions(path, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := svc.client.NewRequest(ctx, http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := new(registryWebhookDeliveriesRoot)
+	resp, err := svc.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, resp, err
+	}
+	if l := root.Links; l != nil {
+		resp.Links = l
+	}
+	if m := root.Meta; m != nil {
+		resp.Meta = m
+	}
+
+	return root.Deliveries, resp, nil
+}
+
+// BulkDeleteTags deletes many tags from a repository concurrently, fanning
+// out to DeleteTag over a bounded worker pool.
+func (svc *RegistryServiceOp) BulkDeleteTags(ctx context.Context, registry, repository string, tags []string, opts *BulkDeleteOptions) (*BulkDeleteResult, *Response, error) {
+	result := bulkDelete(ctx, tags, opts, func(ctx context.Context, tag string) (*Response, error) {
+		return svc.DeleteTag(ctx, registry, repository, tag)
+	})
+
+	return result, nil, nil
+}
+
+// BulkDeleteManifests deletes many manifests from a repository concurrently,
+// fanning out to DeleteManifest over a bounded worker pool.
+func (svc *RegistryServiceOp) BulkDeleteManifests(ctx context.Context, registry, repository string, digests []string, opts *BulkDeleteOptions) (*BulkDeleteResult, *Response, error) {
+	result := bulkDelete(ctx, digests, opts, func(ctx context.Context, digest string) (*Response, error) {
+		return svc.DeleteManifest(ctx, registry, repository, digest)
+	})
+
+	return result, nil, nil
+}
+
+// bulkDelete runs deleteOne for each item over a worker pool bounded by
+// opts.Concurrency, collecting per-item successes and failures. If
+// opts.ContinueOnError is false, it stops dispatching new work as soon as one
+// item fails; items already in flight are still allowed to finish.
+func bulkDelete(ctx context.Context, items []string, opts *BulkDeleteOptions, deleteOne func(ctx context.Context, item string) (*Response, error)) *BulkDeleteResult {
+	concurrency := 1
+	continueOnError := false
+	if opts != nil {
+		if opts.Concurrency > 0 {
+			concurrency = opts.Concurrency
+		}
+		continueOnError = opts.ContinueOnError
+	}
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		result  = &BulkDeleteResult{}
+		sem     = make(chan struct{}, concurrency)
+		stopped atomic.Bool
+	)
+
+	for _, item := range items {
+		sem <- struct{}{}
+		if !continueOnError && stopped.Load() {
+			<-sem
+			break
+		}
+
+		wg.Add(1)
+		go func(item string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			_, err := deleteOne(ctx, item)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				result.Failed = append(result.Failed, &BulkDeleteItemError{Item: item, Error: err.Error()})
+				if !continueOnError {
+					stopped.Store(true)
+				}
+				return
+			}
+			result.Succeeded = append(result.Succeeded, item)
+		}(item)
+	}
+
+	wg.Wait()
+
+	return result
+}
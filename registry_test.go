@@ -326,6 +326,274 @@ func TestRegistry_DeleteManifest(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestRegistry_GetManifest(t *testing.T) {
+	setup()
+	defer teardown()
+
+	want := &Manifest{
+		RegistryName: testRegistry,
+		Repository:   testRepository,
+		MediaType:    "application/vnd.docker.distribution.manifest.list.v2+json",
+		Digest:       testDigest,
+		References: []*ManifestReference{
+			{Digest: "sha256:amd64digest", MediaType: "application/vnd.docker.distribution.manifest.v2+json", Platform: &ManifestPlatform{OS: "linux", Architecture: "amd64"}},
+			{Digest: "sha256:arm64digest", MediaType: "application/vnd.docker.distribution.manifest.v2+json", Platform: &ManifestPlatform{OS: "linux", Architecture: "arm64"}},
+		},
+	}
+	getResponseJSON := `{
+	"manifest": {
+		"registry_name": "` + testRegistry + `",
+		"repository": "` + testRepository + `",
+		"media_type": "application/vnd.docker.distribution.manifest.list.v2+json",
+		"digest": "` + testDigest + `",
+		"references": [
+			{"digest": "sha256:amd64digest", "media_type": "application/vnd.docker.distribution.manifest.v2+json", "platform": {"os": "linux", "architecture": "amd64"}},
+			{"digest": "sha256:arm64digest", "media_type": "application/vnd.docker.distribution.manifest.v2+json", "platform": {"os": "linux", "architecture": "arm64"}}
+		]
+	}
+}`
+
+	mux.HandleFunc(fmt.Sprintf("/v2/registry/%s/repositories/%s/digests/%s", testRegistry, testRepository, testDigest), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, getResponseJSON)
+	})
+
+	got, _, err := client.Registry.GetManifest(ctx, testRegistry, testRepository, testDigest)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
+
+func TestRegistry_DeleteManifestPlatform(t *testing.T) {
+	setup()
+	defer teardown()
+
+	getResponseJSON := `{
+	"manifest": {
+		"registry_name": "` + testRegistry + `",
+		"repository": "` + testRepository + `",
+		"media_type": "application/vnd.docker.distribution.manifest.list.v2+json",
+		"digest": "` + testDigest + `",
+		"references": [
+			{"digest": "sha256:amd64digest", "platform": {"os": "linux", "architecture": "amd64"}},
+			{"digest": "sha256:arm64digest", "platform": {"os": "linux", "architecture": "arm64"}}
+		]
+	}
+}`
+
+	var gotMethod string
+	mux.HandleFunc(fmt.Sprintf("/v2/registry/%s/repositories/%s/digests/%s", testRegistry, testRepository, testDigest), func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, getResponseJSON)
+		case http.MethodPut:
+			gotMethod = http.MethodPut
+			v := new(manifestRoot)
+			err := json.NewDecoder(r.Body).Decode(v)
+			require.NoError(t, err)
+			require.Len(t, v.Manifest.References, 1)
+			require.Equal(t, "sha256:amd64digest", v.Manifest.References[0].Digest)
+		default:
+			t.Fatalf("unexpected method %s", r.Method)
+		}
+	})
+
+	_, err := client.Registry.DeleteManifestPlatform(ctx, testRegistry, testRepository, testDigest, &ManifestPlatform{OS: "linux", Architecture: "arm64"})
+	require.NoError(t, err)
+	require.Equal(t, http.MethodPut, gotMethod)
+}
+
+func TestRegistry_DeleteManifestPlatform_LastReferenceDeletesManifest(t *testing.T) {
+	setup()
+	defer teardown()
+
+	getResponseJSON := `{
+	"manifest": {
+		"registry_name": "` + testRegistry + `",
+		"repository": "` + testRepository + `",
+		"media_type": "application/vnd.docker.distribution.manifest.list.v2+json",
+		"digest": "` + testDigest + `",
+		"references": [
+			{"digest": "sha256:amd64digest", "platform": {"os": "linux", "architecture": "amd64"}}
+		]
+	}
+}`
+
+	var gotMethod string
+	mux.HandleFunc(fmt.Sprintf("/v2/registry/%s/repositories/%s/digests/%s", testRegistry, testRepository, testDigest), func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, getResponseJSON)
+		case http.MethodDelete:
+			gotMethod = http.MethodDelete
+		default:
+			t.Fatalf("unexpected method %s", r.Method)
+		}
+	})
+
+	_, err := client.Registry.DeleteManifestPlatform(ctx, testRegistry, testRepository, testDigest, &ManifestPlatform{OS: "linux", Architecture: "amd64"})
+	require.NoError(t, err)
+	require.Equal(t, http.MethodDelete, gotMethod)
+}
+
+func TestRegistry_DeleteManifestPlatform_NotAManifestList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	getResponseJSON := `{
+	"manifest": {
+		"registry_name": "` + testRegistry + `",
+		"repository": "` + testRepository + `",
+		"media_type": "application/vnd.docker.distribution.manifest.v2+json",
+		"digest": "` + testDigest + `",
+		"references": []
+	}
+}`
+
+	mux.HandleFunc(fmt.Sprintf("/v2/registry/%s/repositories/%s/digests/%s", testRegistry, testRepository, testDigest), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, getResponseJSON)
+	})
+
+	_, err := client.Registry.DeleteManifestPlatform(ctx, testRegistry, testRepository, testDigest, &ManifestPlatform{OS: "linux", Architecture: "amd64"})
+	require.Error(t, err)
+}
+
+func TestRegistry_DeleteManifestPlatform_NoMatchingPlatform(t *testing.T) {
+	setup()
+	defer teardown()
+
+	getResponseJSON := `{
+	"manifest": {
+		"registry_name": "` + testRegistry + `",
+		"repository": "` + testRepository + `",
+		"media_type": "application/vnd.docker.distribution.manifest.list.v2+json",
+		"digest": "` + testDigest + `",
+		"references": [
+			{"digest": "sha256:amd64digest", "platform": {"os": "linux", "architecture": "amd64"}}
+		]
+	}
+}`
+
+	mux.HandleFunc(fmt.Sprintf("/v2/registry/%s/repositories/%s/digests/%s", testRegistry, testRepository, testDigest), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, getResponseJSON)
+	})
+
+	_, err := client.Registry.DeleteManifestPlatform(ctx, testRegistry, testRepository, testDigest, &ManifestPlatform{OS: "linux", Architecture: "arm64"})
+	require.Error(t, err)
+}
+
+func TestRegistry_DeleteManifestPlatform_NilPlatform(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.Registry.DeleteManifestPlatform(ctx, testRegistry, testRepository, testDigest, nil)
+	require.Error(t, err)
+}
+
+func TestRepository_ListV2_PagesByToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	pageOneJSON := `{
+	"repositories": [
+		{
+			"registry_name": "` + testRegistry + `",
+			"name": "` + testRepository + `",
+			"tag_count": 3,
+			"manifest_count": 2
+		}
+	],
+	"links": {
+	    "pages": {
+			"next": "https://api.digitalocean.com/v2/registry/` + testRegistry + `/repositoriesV2?page_token=next-token&per_page=1"
+		}
+	}
+}`
+	pageTwoJSON := `{
+	"repositories": [
+		{
+			"registry_name": "` + testRegistry + `",
+			"name": "other/repository",
+			"tag_count": 1,
+			"manifest_count": 1
+		}
+	],
+	"links": {}
+}`
+
+	mux.HandleFunc(fmt.Sprintf("/v2/registry/%s/repositoriesV2", testRegistry), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if r.URL.Query().Get("page_token") == "next-token" {
+			fmt.Fprint(w, pageTwoJSON)
+			return
+		}
+		fmt.Fprint(w, pageOneJSON)
+	})
+
+	got, resp, err := client.Registry.ListRepositoriesV2(ctx, testRegistry, &TokenListOptions{PerPage: 1})
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Equal(t, testRepository, got[0].Name)
+
+	token, err := resp.Links.NextPageToken()
+	require.NoError(t, err)
+	require.Equal(t, "next-token", token)
+
+	got, resp, err = client.Registry.ListRepositoriesV2(ctx, testRegistry, &TokenListOptions{Page: token, PerPage: 1})
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	require.Equal(t, "other/repository", got[0].Name)
+
+	token, err = resp.Links.NextPageToken()
+	require.NoError(t, err)
+	require.Empty(t, token)
+}
+
+func TestRegistry_ListManifests(t *testing.T) {
+	setup()
+	defer teardown()
+
+	want := []*RegistryManifest{
+		{
+			RegistryName: testRegistry,
+			Repository:   testRepository,
+			Digest:       testDigest,
+			SizeBytes:    testSize,
+			MediaType:    "application/vnd.docker.distribution.manifest.v2+json",
+			Tags:         []string{testTag},
+			Blobs: []*RegistryBlob{
+				{Digest: "sha256:blob1", SizeBytes: testCompressedSize, MediaType: "application/vnd.docker.image.rootfs.diff.tar.gzip"},
+			},
+		},
+	}
+	getResponseJSON := `{
+	"manifests": [
+		{
+			"registry_name": "` + testRegistry + `",
+			"repository": "` + testRepository + `",
+			"digest": "` + testDigest + `",
+			"size_bytes": ` + fmt.Sprintf("%d", testSize) + `,
+			"media_type": "application/vnd.docker.distribution.manifest.v2+json",
+			"tags": ["` + testTag + `"],
+			"blobs": [
+				{"digest": "sha256:blob1", "size_bytes": ` + fmt.Sprintf("%d", testCompressedSize) + `, "media_type": "application/vnd.docker.image.rootfs.diff.tar.gzip"}
+			]
+		}
+	],
+	"links": {}
+}`
+
+	mux.HandleFunc(fmt.Sprintf("/v2/registry/%s/repositories/%s/digests", testRegistry, testRepository), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, getResponseJSON)
+	})
+
+	got, _, err := client.Registry.ListRegistryManifests(ctx, testRegistry, testRepository, &TokenListOptions{})
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
+
 func reifyTemplateStr(t *testing.T, tmplStr string, v interface{}) string {
 	tmpl, err := template.New("meow").Parse(tmplStr)
 	require.NoError(t, err)
@@ -494,3 +762,657 @@ func TestGarbageCollection_Update(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, want, got)
 }
+
+const testRetentionPolicyUUID = "retention-policy-id"
+
+func TestRetentionPolicy_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	createRequest := &RetentionPolicyRequest{
+		Name:         "keep-last-10",
+		Repositories: []string{testRepository},
+		Rules: RetentionRules{
+			KeepLastN: 10,
+		},
+		Schedule: RetentionSchedule{Frequency: RetentionScheduleDaily},
+		Enabled:  true,
+	}
+
+	want := &RetentionPolicy{
+		UUID:         testRetentionPolicyUUID,
+		RegistryName: testRegistry,
+		Name:         createRequest.Name,
+		Repositories: createRequest.Repositories,
+		Rules:        createRequest.Rules,
+		Schedule:     createRequest.Schedule,
+		Enabled:      true,
+		CreatedAt:    testTime,
+	}
+
+	createResponseJSON := `
+{
+	"retention_policy": {
+		"uuid": "` + testRetentionPolicyUUID + `",
+		"registry_name": "` + testRegistry + `",
+		"name": "keep-last-10",
+		"repositories": ["` + testRepository + `"],
+		"rules": {
+			"keep_last_n": 10
+		},
+		"schedule": {
+			"frequency": "daily"
+		},
+		"enabled": true,
+		"created_at": "` + testTimeString + `"
+	}
+}`
+
+	mux.HandleFunc(fmt.Sprintf("/v2/registry/%s/retention-policies", testRegistry), func(w http.ResponseWriter, r *http.Request) {
+		v := new(RetentionPolicyRequest)
+		err := json.NewDecoder(r.Body).Decode(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		testMethod(t, r, http.MethodPost)
+		require.Equal(t, createRequest, v)
+		fmt.Fprint(w, createResponseJSON)
+	})
+
+	got, _, err := client.Registry.CreateRetentionPolicy(ctx, testRegistry, createRequest)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
+
+func TestRetentionPolicy_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	want := []*RetentionPolicy{
+		{
+			UUID:         testRetentionPolicyUUID,
+			RegistryName: testRegistry,
+			Name:         "keep-last-10",
+			Rules:        RetentionRules{KeepLastN: 10},
+			Schedule:     RetentionSchedule{Frequency: RetentionScheduleDaily},
+			Enabled:      true,
+		},
+	}
+
+	getResponseJSON := `{
+	"retention_policies": [
+		{
+			"uuid": "` + testRetentionPolicyUUID + `",
+			"registry_name": "` + testRegistry + `",
+			"name": "keep-last-10",
+			"rules": {
+				"keep_last_n": 10
+			},
+			"schedule": {
+				"frequency": "daily"
+			},
+			"enabled": true
+		}
+	]
+}`
+
+	mux.HandleFunc(fmt.Sprintf("/v2/registry/%s/retention-policies", testRegistry), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, getResponseJSON)
+	})
+
+	got, _, err := client.Registry.ListRetentionPolicies(ctx, testRegistry, nil)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
+
+func TestRetentionPolicy_Update(t *testing.T) {
+	setup()
+	defer teardown()
+
+	updateRequest := &RetentionPolicyRequest{
+		Name:  "keep-last-10",
+		Rules: RetentionRules{KeepLastN: 20},
+		Schedule: RetentionSchedule{
+			Frequency: RetentionScheduleWeekly,
+		},
+		Enabled: true,
+	}
+
+	want := &RetentionPolicy{
+		UUID:         testRetentionPolicyUUID,
+		RegistryName: testRegistry,
+		Name:         updateRequest.Name,
+		Rules:        updateRequest.Rules,
+		Schedule:     updateRequest.Schedule,
+		Enabled:      true,
+		UpdatedAt:    testTime,
+	}
+
+	updateResponseJSON := `
+{
+	"retention_policy": {
+		"uuid": "` + testRetentionPolicyUUID + `",
+		"registry_name": "` + testRegistry + `",
+		"name": "keep-last-10",
+		"rules": {
+			"keep_last_n": 20
+		},
+		"schedule": {
+			"frequency": "weekly"
+		},
+		"enabled": true,
+		"updated_at": "` + testTimeString + `"
+	}
+}`
+
+	mux.HandleFunc(fmt.Sprintf("/v2/registry/%s/retention-policies/%s", testRegistry, testRetentionPolicyUUID), func(w http.ResponseWriter, r *http.Request) {
+		v := new(RetentionPolicyRequest)
+		err := json.NewDecoder(r.Body).Decode(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		testMethod(t, r, http.MethodPut)
+		require.Equal(t, updateRequest, v)
+		fmt.Fprint(w, updateResponseJSON)
+	})
+
+	got, _, err := client.Registry.UpdateRetentionPolicy(ctx, testRegistry, testRetentionPolicyUUID, updateRequest)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
+
+func TestRetentionPolicy_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/v2/registry/%s/retention-policies/%s", testRegistry, testRetentionPolicyUUID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+	})
+
+	_, err := client.Registry.DeleteRetentionPolicy(ctx, testRegistry, testRetentionPolicyUUID)
+	require.NoError(t, err)
+}
+
+func TestRetentionPolicy_DryRun(t *testing.T) {
+	setup()
+	defer teardown()
+
+	policy := &RetentionPolicyRequest{
+		Name:  "keep-last-10",
+		Rules: RetentionRules{KeepLastN: 10},
+	}
+
+	want := &RetentionDryRun{
+		Tags: []*RepositoryTag{
+			{
+				RegistryName:   testRegistry,
+				Repository:     testRepository,
+				Tag:            testTag,
+				ManifestDigest: testDigest,
+			},
+		},
+		EstimatedFreedBytes: testGCFreedBytes,
+	}
+
+	dryRunResponseJSON := `
+{
+	"retention_dry_run": {
+		"tags": [
+			{
+				"registry_name": "` + testRegistry + `",
+				"repository": "` + testRepository + `",
+				"tag": "` + testTag + `",
+				"manifest_digest": "` + testDigest + `"
+			}
+		],
+		"estimated_freed_bytes": ` + fmt.Sprintf("%d", testGCFreedBytes) + `
+	}
+}`
+
+	mux.HandleFunc(fmt.Sprintf("/v2/registry/%s/retention-policies/dry-run", testRegistry), func(w http.ResponseWriter, r *http.Request) {
+		v := new(RetentionPolicyRequest)
+		err := json.NewDecoder(r.Body).Decode(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		testMethod(t, r, http.MethodPost)
+		require.Equal(t, policy, v)
+		fmt.Fprint(w, dryRunResponseJSON)
+	})
+
+	got, _, err := client.Registry.DryRunRetentionPolicy(ctx, testRegistry, policy)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
+
+func TestRetentionRules_KeepNewerThanSecondsJSONRoundTrip(t *testing.T) {
+	rules := RetentionRules{KeepNewerThanSeconds: 2592000}
+
+	data, err := json.Marshal(rules)
+	require.NoError(t, err)
+	require.Equal(t, `{"keep_newer_than_seconds":2592000}`, string(data))
+
+	var got RetentionRules
+	err = json.Unmarshal(data, &got)
+	require.NoError(t, err)
+	require.Equal(t, rules, got)
+}
+
+func TestRegistry_ScanTag(t *testing.T) {
+	setup()
+	defer teardown()
+
+	want := &ScanReport{
+		RegistryName: testRegistry,
+		Repository:   testRepository,
+		Digest:       testDigest,
+		Status:       ScanStatusQueued,
+	}
+	scanResponseJSON := `
+{
+	"scan_report": {
+		"registry_name": "` + testRegistry + `",
+		"repository": "` + testRepository + `",
+		"digest": "` + testDigest + `",
+		"status": "queued"
+	}
+}`
+
+	mux.HandleFunc(fmt.Sprintf("/v2/registry/%s/repositories/%s/tags/%s/scan", testRegistry, testRepository, testTag), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, scanResponseJSON)
+	})
+
+	got, _, err := client.Registry.ScanTag(ctx, testRegistry, testRepository, testTag)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
+
+func TestRegistry_GetScanReport(t *testing.T) {
+	setup()
+	defer teardown()
+
+	want := &ScanReport{
+		RegistryName: testRegistry,
+		Repository:   testRepository,
+		Digest:       testDigest,
+		Status:       ScanStatusComplete,
+		Summary: VulnerabilitySummary{
+			Critical: 1,
+			High:     2,
+		},
+	}
+	getResponseJSON := `
+{
+	"scan_report": {
+		"registry_name": "` + testRegistry + `",
+		"repository": "` + testRepository + `",
+		"digest": "` + testDigest + `",
+		"status": "complete",
+		"summary": {
+			"critical": 1,
+			"high": 2
+		}
+	}
+}`
+
+	mux.HandleFunc(fmt.Sprintf("/v2/registry/%s/repositories/%s/digests/%s/scan", testRegistry, testRepository, testDigest), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, getResponseJSON)
+	})
+
+	got, _, err := client.Registry.GetScanReport(ctx, testRegistry, testRepository, testDigest)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
+
+func TestScanReport_HasBlockingVulnerabilities(t *testing.T) {
+	report := &ScanReport{
+		Summary: VulnerabilitySummary{
+			Medium: 1,
+		},
+	}
+
+	require.True(t, report.HasBlockingVulnerabilities(SeverityLow))
+	require.True(t, report.HasBlockingVulnerabilities(SeverityMedium))
+	require.False(t, report.HasBlockingVulnerabilities(SeverityHigh))
+}
+
+func TestRegistry_ListVulnerabilities(t *testing.T) {
+	setup()
+	defer teardown()
+
+	want := []*Vulnerability{
+		{
+			CVE:              "CVE-2023-12345",
+			Severity:         SeverityHigh,
+			Package:          "openssl",
+			InstalledVersion: "1.1.1",
+			FixedVersion:     "1.1.2",
+			Layer:            "sha256:layerdigest",
+			Description:      "a vulnerability",
+			Links:            []string{"https://example.com/CVE-2023-12345"},
+		},
+	}
+	getResponseJSON := `{
+	"vulnerabilities": [
+		{
+			"cve": "CVE-2023-12345",
+			"severity": "high",
+			"package": "openssl",
+			"installed_version": "1.1.1",
+			"fixed_version": "1.1.2",
+			"layer": "sha256:layerdigest",
+			"description": "a vulnerability",
+			"links": ["https://example.com/CVE-2023-12345"]
+		}
+	]
+}`
+
+	mux.HandleFunc(fmt.Sprintf("/v2/registry/%s/repositories/%s/digests/%s/vulnerabilities", testRegistry, testRepository, testDigest), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, getResponseJSON)
+	})
+
+	got, _, err := client.Registry.ListVulnerabilities(ctx, testRegistry, testRepository, testDigest, nil)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
+
+const testWebhookUUID = "webhook-id"
+
+func TestRegistryWebhook_Create(t *testing.T) {
+	setup()
+	defer teardown()
+
+	createRequest := &RegistryWebhookRequest{
+		Name:       "ci-notify",
+		TargetURL:  "https://example.com/hooks/registry",
+		Secret:     "shh",
+		EventTypes: []string{"push", "delete"},
+		Enabled:    true,
+	}
+
+	want := &RegistryWebhook{
+		UUID:         testWebhookUUID,
+		RegistryName: testRegistry,
+		Name:         createRequest.Name,
+		TargetURL:    createRequest.TargetURL,
+		EventTypes:   createRequest.EventTypes,
+		Enabled:      true,
+		CreatedAt:    testTime,
+	}
+
+	createResponseJSON := `
+{
+	"webhook": {
+		"uuid": "` + testWebhookUUID + `",
+		"registry_name": "` + testRegistry + `",
+		"name": "ci-notify",
+		"target_url": "https://example.com/hooks/registry",
+		"event_types": ["push", "delete"],
+		"enabled": true,
+		"created_at": "` + testTimeString + `"
+	}
+}`
+
+	mux.HandleFunc(fmt.Sprintf("/v2/registry/%s/webhooks", testRegistry), func(w http.ResponseWriter, r *http.Request) {
+		v := new(RegistryWebhookRequest)
+		err := json.NewDecoder(r.Body).Decode(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		testMethod(t, r, http.MethodPost)
+		require.Equal(t, createRequest, v)
+		fmt.Fprint(w, createResponseJSON)
+	})
+
+	got, _, err := client.Registry.CreateWebhook(ctx, testRegistry, createRequest)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
+
+func TestRegistryWebhook_List(t *testing.T) {
+	setup()
+	defer teardown()
+
+	want := []*RegistryWebhook{
+		{
+			UUID:         testWebhookUUID,
+			RegistryName: testRegistry,
+			Name:         "ci-notify",
+			TargetURL:    "https://example.com/hooks/registry",
+			Enabled:      true,
+		},
+	}
+	getResponseJSON := `{
+	"webhooks": [
+		{
+			"uuid": "` + testWebhookUUID + `",
+			"registry_name": "` + testRegistry + `",
+			"name": "ci-notify",
+			"target_url": "https://example.com/hooks/registry",
+			"enabled": true
+		}
+	]
+}`
+
+	mux.HandleFunc(fmt.Sprintf("/v2/registry/%s/webhooks", testRegistry), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, getResponseJSON)
+	})
+
+	got, _, err := client.Registry.ListWebhooks(ctx, testRegistry, nil)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
+
+func TestRegistryWebhook_Get(t *testing.T) {
+	setup()
+	defer teardown()
+
+	want := &RegistryWebhook{
+		UUID:         testWebhookUUID,
+		RegistryName: testRegistry,
+		Name:         "ci-notify",
+	}
+	getResponseJSON := `
+{
+	"webhook": {
+		"uuid": "` + testWebhookUUID + `",
+		"registry_name": "` + testRegistry + `",
+		"name": "ci-notify"
+	}
+}`
+
+	mux.HandleFunc(fmt.Sprintf("/v2/registry/%s/webhooks/%s", testRegistry, testWebhookUUID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, getResponseJSON)
+	})
+
+	got, _, err := client.Registry.GetWebhook(ctx, testRegistry, testWebhookUUID)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
+
+func TestRegistryWebhook_Update(t *testing.T) {
+	setup()
+	defer teardown()
+
+	updateRequest := &RegistryWebhookRequest{
+		Name:       "ci-notify",
+		TargetURL:  "https://example.com/hooks/registry-v2",
+		EventTypes: []string{"push"},
+		Enabled:    false,
+	}
+
+	want := &RegistryWebhook{
+		UUID:         testWebhookUUID,
+		RegistryName: testRegistry,
+		Name:         updateRequest.Name,
+		TargetURL:    updateRequest.TargetURL,
+		EventTypes:   updateRequest.EventTypes,
+		Enabled:      false,
+		UpdatedAt:    testTime,
+	}
+
+	updateResponseJSON := `
+{
+	"webhook": {
+		"uuid": "` + testWebhookUUID + `",
+		"registry_name": "` + testRegistry + `",
+		"name": "ci-notify",
+		"target_url": "https://example.com/hooks/registry-v2",
+		"event_types": ["push"],
+		"enabled": false,
+		"updated_at": "` + testTimeString + `"
+	}
+}`
+
+	mux.HandleFunc(fmt.Sprintf("/v2/registry/%s/webhooks/%s", testRegistry, testWebhookUUID), func(w http.ResponseWriter, r *http.Request) {
+		v := new(RegistryWebhookRequest)
+		err := json.NewDecoder(r.Body).Decode(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		testMethod(t, r, http.MethodPut)
+		require.Equal(t, updateRequest, v)
+		fmt.Fprint(w, updateResponseJSON)
+	})
+
+	got, _, err := client.Registry.UpdateWebhook(ctx, testRegistry, testWebhookUUID, updateRequest)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
+
+func TestRegistryWebhook_Delete(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/v2/registry/%s/webhooks/%s", testRegistry, testWebhookUUID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+	})
+
+	_, err := client.Registry.DeleteWebhook(ctx, testRegistry, testWebhookUUID)
+	require.NoError(t, err)
+}
+
+func TestRegistryWebhook_Ping(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/v2/registry/%s/webhooks/%s/ping", testRegistry, testWebhookUUID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+	})
+
+	_, err := client.Registry.PingWebhook(ctx, testRegistry, testWebhookUUID)
+	require.NoError(t, err)
+}
+
+func TestRegistryWebhook_ListDeliveries(t *testing.T) {
+	setup()
+	defer teardown()
+
+	want := []*RegistryWebhookDelivery{
+		{
+			UUID:        "delivery-1",
+			WebhookUUID: testWebhookUUID,
+			EventType:   "push",
+			StatusCode:  500,
+			Success:     false,
+		},
+	}
+	getResponseJSON := `{
+	"deliveries": [
+		{
+			"uuid": "delivery-1",
+			"webhook_uuid": "` + testWebhookUUID + `",
+			"event_type": "push",
+			"status_code": 500,
+			"success": false
+		}
+	]
+}`
+
+	mux.HandleFunc(fmt.Sprintf("/v2/registry/%s/webhooks/%s/deliveries", testRegistry, testWebhookUUID), func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, getResponseJSON)
+	})
+
+	got, _, err := client.Registry.ListWebhookDeliveries(ctx, testRegistry, testWebhookUUID, nil)
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
+
+func TestRegistry_BulkDeleteTags(t *testing.T) {
+	setup()
+	defer teardown()
+
+	tags := []string{"tag-1", "tag-2", "tag-3"}
+
+	for _, tag := range tags {
+		tag := tag
+		mux.HandleFunc(fmt.Sprintf("/v2/registry/%s/repositories/%s/tags/%s", testRegistry, testRepository, tag), func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodDelete)
+			if tag == "tag-2" {
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		})
+	}
+
+	got, resp, err := client.Registry.BulkDeleteTags(ctx, testRegistry, testRepository, tags, &BulkDeleteOptions{Concurrency: 2, ContinueOnError: true})
+	require.NoError(t, err)
+	require.Nil(t, resp)
+	require.ElementsMatch(t, []string{"tag-1", "tag-3"}, got.Succeeded)
+	require.Len(t, got.Failed, 1)
+	require.Equal(t, "tag-2", got.Failed[0].Item)
+}
+
+func TestRegistry_BulkDeleteTags_DoesNotCancelInFlightOnFailure(t *testing.T) {
+	setup()
+	defer teardown()
+
+	tags := []string{"fail", "slow-1", "slow-2", "slow-3"}
+
+	for _, tag := range tags {
+		tag := tag
+		mux.HandleFunc(fmt.Sprintf("/v2/registry/%s/repositories/%s/tags/%s", testRegistry, testRepository, tag), func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodDelete)
+			if tag == "fail" {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			time.Sleep(100 * time.Millisecond)
+		})
+	}
+
+	got, _, err := client.Registry.BulkDeleteTags(ctx, testRegistry, testRepository, tags, &BulkDeleteOptions{Concurrency: len(tags), ContinueOnError: false})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"slow-1", "slow-2", "slow-3"}, got.Succeeded)
+	require.Len(t, got.Failed, 1)
+	require.Equal(t, "fail", got.Failed[0].Item)
+}
+
+func TestRegistry_BulkDeleteManifests(t *testing.T) {
+	setup()
+	defer teardown()
+
+	digests := []string{"sha256:digest1", "sha256:digest2"}
+
+	for _, digest := range digests {
+		mux.HandleFunc(fmt.Sprintf("/v2/registry/%s/repositories/%s/digests/%s", testRegistry, testRepository, digest), func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodDelete)
+		})
+	}
+
+	got, _, err := client.Registry.BulkDeleteManifests(ctx, testRegistry, testRepository, digests, nil)
+	require.NoError(t, err)
+	require.ElementsMatch(t, digests, got.Succeeded)
+	require.Empty(t, got.Failed)
+}